@@ -0,0 +1,101 @@
+package calendar
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// DateEntry is a text Entry that validates typed dates against Layout and
+// offers a popup calendar view (CreateCalendarView), via its ActionItem button, as an
+// alternative to clicking through months.
+type DateEntry struct {
+	widget.Entry
+
+	// Layout is the time layout typed dates must match, e.g. "2006-01-02"
+	// or "02/01/2006". Defaults to "2006-01-02".
+	Layout string
+
+	// OnDateChanged is called whenever the date changes, whether typed or
+	// picked from the popup calendar.
+	OnDateChanged func(time.Time)
+
+	date  time.Time
+	popUp *widget.PopUp
+}
+
+// NewDateEntry creates a DateEntry showing date, formatted with layout.
+func NewDateEntry(date time.Time, layout string) *DateEntry {
+	if layout == "" {
+		layout = "2006-01-02"
+	}
+
+	e := &DateEntry{Layout: layout, date: date}
+	e.ExtendBaseWidget(e)
+	e.Validator = func(text string) error {
+		_, err := time.Parse(e.Layout, text)
+		return err
+	}
+	e.OnSubmitted = func(text string) {
+		if t, err := time.Parse(e.Layout, text); err == nil {
+			e.setDate(t)
+		}
+	}
+	e.ActionItem = widget.NewButtonWithIcon("", theme.MenuDropDownIcon(), e.showPopUp)
+	e.Entry.SetText(date.Format(layout))
+	return e
+}
+
+// SetDate sets the current date, updates the displayed text and fires
+// OnDateChanged.
+func (e *DateEntry) SetDate(date time.Time) {
+	e.Entry.SetText(date.Format(e.Layout))
+	e.setDate(date)
+}
+
+// Date returns the currently selected date.
+func (e *DateEntry) Date() time.Time {
+	return e.date
+}
+
+func (e *DateEntry) setDate(date time.Time) {
+	e.date = date
+	if e.popUp != nil {
+		e.popUp.Hide()
+	}
+	if e.OnDateChanged != nil {
+		e.OnDateChanged(date)
+	}
+}
+
+func (e *DateEntry) showPopUp() {
+	if e.Disabled() {
+		return
+	}
+
+	view := CreateCalendarView(e.date, nil, func(selected time.Time) {
+		e.Entry.SetText(selected.Format(e.Layout))
+		e.setDate(selected)
+	}, nil)
+
+	c := fyne.CurrentApp().Driver().CanvasForObject(e)
+	e.popUp = widget.NewPopUp(view, c)
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(e)
+	pos.Y += e.Size().Height
+	e.popUp.ShowAtPosition(pos)
+}
+
+// Enable enables the entry and its popup calendar button.
+func (e *DateEntry) Enable() {
+	e.Entry.Enable()
+}
+
+// Disable disables the entry, hiding any open popup calendar.
+func (e *DateEntry) Disable() {
+	e.Entry.Disable()
+	if e.popUp != nil {
+		e.popUp.Hide()
+	}
+}
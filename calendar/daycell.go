@@ -0,0 +1,57 @@
+package calendar
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+)
+
+// dayCell is a single day button that reports whether it was clicked with
+// the shift key held, so CalendarWidget can tell a plain click from a
+// shift-click range selection.
+type dayCell struct {
+	widget.BaseWidget
+	label    string
+	selected bool
+	onTap    func(shift bool)
+
+	shiftHeld bool
+}
+
+func newDayCell(label string, onTap func(shift bool)) *dayCell {
+	c := &dayCell{label: label, onTap: onTap}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+func (c *dayCell) setSelected(selected bool) {
+	c.selected = selected
+}
+
+func (c *dayCell) CreateRenderer() fyne.WidgetRenderer {
+	btn := widget.NewButton(c.label, nil)
+	if c.selected {
+		btn.Importance = widget.HighImportance
+	}
+	return widget.NewSimpleRenderer(btn)
+}
+
+// Tapped handles a plain click. Shift-clicks are reported by MouseUp instead
+// so the range selection only fires once.
+func (c *dayCell) Tapped(*fyne.PointEvent) {
+	if c.shiftHeld {
+		c.shiftHeld = false
+		return
+	}
+	c.onTap(false)
+}
+
+func (c *dayCell) MouseDown(e *desktop.MouseEvent) {
+	c.shiftHeld = e.Modifier&desktop.ShiftModifier != 0
+}
+
+func (c *dayCell) MouseUp(e *desktop.MouseEvent) {
+	if c.shiftHeld {
+		c.onTap(true)
+	}
+}
@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"io"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+// CalendarEvent is a single VEVENT loaded from an iCalendar file, reduced to
+// the fields the calendar view needs to decorate a day.
+type CalendarEvent struct {
+	Summary string
+	Start   time.Time
+	End     time.Time
+}
+
+// ParseICS reads an iCalendar document from r and returns its VEVENT
+// entries as CalendarEvents.
+func ParseICS(r io.Reader) ([]CalendarEvent, error) {
+	cal, err := ics.ParseCalendar(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []CalendarEvent
+	for _, vevent := range cal.Events() {
+		start, err := vevent.GetStartAt()
+		if err != nil {
+			continue
+		}
+
+		summary := vevent.GetProperty(ics.ComponentPropertySummary)
+		text := ""
+		if summary != nil {
+			text = summary.Value
+		}
+
+		event := CalendarEvent{Summary: text, Start: start}
+		if end, err := vevent.GetEndAt(); err == nil {
+			event.End = end
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// eventsOnDay returns the events from events that start on day, ignoring
+// time of day.
+func eventsOnDay(events []CalendarEvent, day time.Time) []CalendarEvent {
+	var onDay []CalendarEvent
+	for _, e := range events {
+		if sameDate(e.Start, day) {
+			onDay = append(onDay, e)
+		}
+	}
+	return onDay
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
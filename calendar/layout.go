@@ -0,0 +1,88 @@
+package calendar
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// CalendarLayout arranges calendar cells in a uniform grid of cellSize cells
+// separated by padding, rather than letting a parent VBox stretch them.
+// When adapt is set, it flips between a columns-wide grid in landscape and a
+// single-column list in portrait, following fyne.CurrentDevice().Orientation().
+type CalendarLayout struct {
+	cellSize fyne.Size
+	columns  int
+	padding  float32
+	adapt    bool
+}
+
+// NewCalendarLayout creates a CalendarLayout with columns-wide cells of
+// cellSize, separated by padding.
+func NewCalendarLayout(cellSize fyne.Size, columns int, padding float32) *CalendarLayout {
+	return &CalendarLayout{cellSize: cellSize, columns: columns, padding: padding}
+}
+
+// NewAdaptiveCalendarLayout is like NewCalendarLayout but drops to a single
+// column in portrait orientation, for small/handheld devices.
+func NewAdaptiveCalendarLayout(cellSize fyne.Size, columns int, padding float32) *CalendarLayout {
+	return &CalendarLayout{cellSize: cellSize, columns: columns, padding: padding, adapt: true}
+}
+
+func (l *CalendarLayout) columnCount() int {
+	if !l.adapt {
+		return l.columns
+	}
+
+	switch fyne.CurrentDevice().Orientation() {
+	case fyne.OrientationVertical, fyne.OrientationVerticalUpsideDown:
+		return 1
+	default:
+		return l.columns
+	}
+}
+
+func visibleCount(objects []fyne.CanvasObject) int {
+	count := 0
+	for _, o := range objects {
+		if o.Visible() {
+			count++
+		}
+	}
+	return count
+}
+
+// Layout positions each visible object into a uniform cellSize grid.
+func (l *CalendarLayout) Layout(objects []fyne.CanvasObject, _ fyne.Size) {
+	cols := l.columnCount()
+	row, col := 0, 0
+	for _, o := range objects {
+		if !o.Visible() {
+			continue
+		}
+
+		x := float32(col) * (l.cellSize.Width + l.padding)
+		y := float32(row) * (l.cellSize.Height + l.padding)
+		o.Move(fyne.NewPos(x, y))
+		o.Resize(l.cellSize)
+
+		col++
+		if col >= cols {
+			col = 0
+			row++
+		}
+	}
+}
+
+// MinSize computes the space needed to lay out every visible object, with
+// the row count derived from how many of them there are.
+func (l *CalendarLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	cols := l.columnCount()
+	count := visibleCount(objects)
+	if count == 0 || cols == 0 {
+		return fyne.NewSize(0, 0)
+	}
+
+	rows := (count + cols - 1) / cols
+	width := float32(cols)*l.cellSize.Width + float32(cols-1)*l.padding
+	height := float32(rows)*l.cellSize.Height + float32(rows-1)*l.padding
+	return fyne.NewSize(width, height)
+}
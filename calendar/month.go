@@ -0,0 +1,89 @@
+package calendar
+
+import "time"
+
+// SelectableMonth is a month-of-dates selection model, decoupled from any
+// widget, so a UI can drive it from clicks while tests or other callers can
+// drive it directly.
+type SelectableMonth struct {
+	Year  int
+	Month time.Month
+
+	// Pad is the weekday (Sunday=0) the 1st of the month falls on, i.e. how
+	// many leading blank cells a grid of this month needs.
+	Pad int
+
+	// DaysIn is the number of days in Month.
+	DaysIn int
+
+	// S holds per-day selection state, indexed by day of month (1..DaysIn).
+	S [32]bool
+
+	// Ws holds per-weekday-column selection state, indexed by time.Weekday.
+	Ws [7]bool
+}
+
+// NewSelectableMonth builds a SelectableMonth for the month containing t,
+// with no days selected.
+func NewSelectableMonth(t time.Time) *SelectableMonth {
+	m := &SelectableMonth{Year: t.Year(), Month: t.Month()}
+	m.recompute()
+	return m
+}
+
+func (m *SelectableMonth) recompute() {
+	first := time.Date(m.Year, m.Month, 1, 0, 0, 0, 0, time.Local)
+	m.Pad = int(first.Weekday())
+	m.DaysIn = first.AddDate(0, 1, -1).Day()
+}
+
+// Previous moves the model back one month, clearing all selections.
+func (m *SelectableMonth) Previous() {
+	m.shiftMonth(-1)
+}
+
+// Next moves the model forward one month, clearing all selections.
+func (m *SelectableMonth) Next() {
+	m.shiftMonth(1)
+}
+
+func (m *SelectableMonth) shiftMonth(delta int) {
+	first := time.Date(m.Year, m.Month, 1, 0, 0, 0, 0, time.Local).AddDate(0, delta, 0)
+	m.Year, m.Month = first.Year(), first.Month()
+	m.S = [32]bool{}
+	m.Ws = [7]bool{}
+	m.recompute()
+}
+
+// SelectWeekday marks every occurrence of weekday d in the visible month as
+// selected.
+func (m *SelectableMonth) SelectWeekday(d time.Weekday) {
+	m.Ws[d] = true
+	m.forEachDayOfWeekday(d, func(day int) { m.S[day] = true })
+}
+
+// DeselectWeekday clears the selection for every occurrence of weekday d.
+func (m *SelectableMonth) DeselectWeekday(d time.Weekday) {
+	m.Ws[d] = false
+	m.forEachDayOfWeekday(d, func(day int) { m.S[day] = false })
+}
+
+func (m *SelectableMonth) forEachDayOfWeekday(d time.Weekday, fn func(day int)) {
+	for day := 1; day <= m.DaysIn; day++ {
+		date := time.Date(m.Year, m.Month, day, 0, 0, 0, 0, time.Local)
+		if date.Weekday() == d {
+			fn(day)
+		}
+	}
+}
+
+// SelectedDates returns every selected day of the visible month, in order.
+func (m *SelectableMonth) SelectedDates() []time.Time {
+	var dates []time.Time
+	for day := 1; day <= m.DaysIn; day++ {
+		if m.S[day] {
+			dates = append(dates, time.Date(m.Year, m.Month, day, 0, 0, 0, 0, time.Local))
+		}
+	}
+	return dates
+}
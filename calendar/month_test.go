@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSelectableMonthNextPrevious(t *testing.T) {
+	m := NewSelectableMonth(time.Date(2026, time.January, 15, 0, 0, 0, 0, time.Local))
+	if m.Year != 2026 || m.Month != time.January || m.DaysIn != 31 {
+		t.Fatalf("unexpected initial state: %+v", m)
+	}
+
+	m.S[1] = true
+	m.Next()
+	if m.Year != 2026 || m.Month != time.February {
+		t.Fatalf("Next() did not roll forward: %+v", m)
+	}
+	if m.DaysIn != 28 {
+		t.Fatalf("February 2026 DaysIn = %d, want 28", m.DaysIn)
+	}
+	if m.S[1] {
+		t.Fatal("Next() did not clear the previous month's selection")
+	}
+
+	m.Previous()
+	if m.Year != 2026 || m.Month != time.January {
+		t.Fatalf("Previous() did not roll back: %+v", m)
+	}
+
+	dec := NewSelectableMonth(time.Date(2025, time.December, 1, 0, 0, 0, 0, time.Local))
+	dec.Next()
+	if dec.Year != 2026 || dec.Month != time.January {
+		t.Fatalf("year rollover failed: %+v", dec)
+	}
+}
+
+func TestSelectableMonthWeekdaySelection(t *testing.T) {
+	m := NewSelectableMonth(time.Date(2026, time.July, 1, 0, 0, 0, 0, time.Local))
+
+	m.SelectWeekday(time.Sunday)
+	if !m.Ws[time.Sunday] {
+		t.Fatal("SelectWeekday did not set the Ws flag")
+	}
+
+	dates := m.SelectedDates()
+	if len(dates) == 0 {
+		t.Fatal("SelectWeekday selected no days")
+	}
+	for _, d := range dates {
+		if d.Weekday() != time.Sunday {
+			t.Fatalf("selected date %v is not a Sunday", d)
+		}
+	}
+
+	m.DeselectWeekday(time.Sunday)
+	if m.Ws[time.Sunday] {
+		t.Fatal("DeselectWeekday did not clear the Ws flag")
+	}
+	if len(m.SelectedDates()) != 0 {
+		t.Fatal("DeselectWeekday did not clear the day selections")
+	}
+}
@@ -0,0 +1,141 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// CreateCalendarView creates a calendar view widget. Days that have one or
+// more events are decorated with widget.HighImportance; tapping a decorated
+// day both fires onDateSelected and offers to jump onEventSelected to one of
+// the day's event start times.
+func CreateCalendarView(initialTime time.Time, events []CalendarEvent, onDateSelected func(time.Time), onEventSelected func(time.Time)) fyne.CanvasObject {
+	currentMonth := initialTime
+
+	// Month/Year label
+	monthYearLabel := widget.NewLabel("")
+	monthYearLabel.Alignment = fyne.TextAlignCenter
+	monthYearLabel.TextStyle = fyne.TextStyle{Bold: true}
+
+	// Navigation buttons
+	prevBtn := widget.NewButton("<", nil)
+	nextBtn := widget.NewButton(">", nil)
+
+	// Calendar grid, laid out by CalendarLayout so day cells are uniformly
+	// sized rather than stretched by the parent VBox. Adaptive so handheld
+	// devices in portrait orientation get a single column.
+	cellLayout := NewAdaptiveCalendarLayout(fyne.NewSize(40, 36), 7, theme.Padding())
+	calendarGrid := container.New(cellLayout)
+
+	updateCalendar := func() {
+		// Update month/year label
+		monthYearLabel.SetText(currentMonth.Format("January 2006"))
+
+		// Clear grid
+		calendarGrid.Objects = nil
+
+		// Add day headers
+		days := []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+		for _, day := range days {
+			dayLabel := widget.NewLabel(day)
+			dayLabel.Alignment = fyne.TextAlignCenter
+			calendarGrid.Add(dayLabel)
+		}
+
+		// Get first day of month and number of days
+		firstOfMonth := time.Date(currentMonth.Year(), currentMonth.Month(), 1, 0, 0, 0, 0, time.Local)
+		lastOfMonth := firstOfMonth.AddDate(0, 1, -1)
+		firstDayWeekday := int(firstOfMonth.Weekday())
+		daysInMonth := lastOfMonth.Day()
+
+		// Add empty cells for days before the first of the month
+		for i := 0; i < firstDayWeekday; i++ {
+			calendarGrid.Add(widget.NewLabel(""))
+		}
+
+		// Add day buttons
+		today := time.Now()
+		for day := 1; day <= daysInMonth; day++ {
+			dayTime := time.Date(currentMonth.Year(), currentMonth.Month(), day, 0, 0, 0, 0, time.Local)
+			isToday := dayTime.Year() == today.Year() &&
+				dayTime.Month() == today.Month() &&
+				dayTime.Day() == today.Day()
+			dayEvents := eventsOnDay(events, dayTime)
+
+			dayBtn := widget.NewButton(fmt.Sprintf("%d", day), nil)
+
+			if isToday || len(dayEvents) > 0 {
+				dayBtn.Importance = widget.HighImportance
+			}
+
+			dayBtn.OnTapped = func() {
+				onDateSelected(dayTime)
+				if len(dayEvents) > 0 {
+					showEventsPopup(dayBtn, dayEvents, onEventSelected)
+				}
+			}
+
+			calendarGrid.Add(dayBtn)
+		}
+
+		calendarGrid.Refresh()
+	}
+
+	// Setup navigation
+	prevBtn.OnTapped = func() {
+		currentMonth = currentMonth.AddDate(0, -1, 0)
+		updateCalendar()
+	}
+
+	nextBtn.OnTapped = func() {
+		currentMonth = currentMonth.AddDate(0, 1, 0)
+		updateCalendar()
+	}
+
+	// Initial calendar update
+	updateCalendar()
+
+	// Navigation header
+	navHeader := container.NewBorder(nil, nil, prevBtn, nextBtn, monthYearLabel)
+
+	// Combine everything
+	calendarContainer := container.NewVBox(
+		navHeader,
+		widget.NewSeparator(),
+		calendarGrid,
+	)
+
+	return calendarContainer
+}
+
+// showEventsPopup lists dayEvents in a popup anchored below anchor; picking
+// one calls onEventSelected with its start time.
+func showEventsPopup(anchor fyne.CanvasObject, dayEvents []CalendarEvent, onEventSelected func(time.Time)) {
+	c := fyne.CurrentApp().Driver().CanvasForObject(anchor)
+	if c == nil {
+		return
+	}
+
+	var popUp *widget.PopUp
+	rows := container.NewVBox()
+	for _, e := range dayEvents {
+		event := e
+		label := fmt.Sprintf("%s  %s", event.Start.Format("15:04"), event.Summary)
+		rows.Add(widget.NewButton(label, func() {
+			if onEventSelected != nil {
+				onEventSelected(event.Start)
+			}
+			popUp.Hide()
+		}))
+	}
+
+	popUp = widget.NewPopUp(rows, c)
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(anchor)
+	pos.Y += anchor.Size().Height
+	popUp.ShowAtPosition(pos)
+}
@@ -0,0 +1,216 @@
+// Package calendar provides reusable Fyne widgets for picking dates: a
+// month-grid CalendarWidget and an Entry-based DateEntry with a popup
+// calendar.
+package calendar
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var weekdays = []time.Weekday{
+	time.Sunday, time.Monday, time.Tuesday, time.Wednesday,
+	time.Thursday, time.Friday, time.Saturday,
+}
+var weekdayNames = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// CalendarWidget is a month-grid calendar rendered from a SelectableMonth,
+// supporting shift-click range selection and weekday-column selection.
+type CalendarWidget struct {
+	widget.BaseWidget
+	currentTime    time.Time
+	onDateSelected func(time.Time)
+
+	// Model backs the rendered grid. Replacing it and calling Refresh moves
+	// the widget to a different month.
+	Model *SelectableMonth
+
+	// OnSelectionChanged is called with every selected date whenever the
+	// selection changes, whether via a day click or a weekday header toggle.
+	OnSelectionChanged func([]time.Time)
+
+	// Events are decorated onto the day they fall on.
+	Events []CalendarEvent
+
+	lastClickedDay int
+}
+
+// NewCalendarWidget creates a CalendarWidget showing the month containing
+// initialTime. onDateSelected, if non-nil, fires on every day click in
+// addition to OnSelectionChanged.
+func NewCalendarWidget(initialTime time.Time, onDateSelected func(time.Time)) *CalendarWidget {
+	c := &CalendarWidget{
+		currentTime:    initialTime,
+		onDateSelected: onDateSelected,
+		Model:          NewSelectableMonth(initialTime),
+	}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+func (c *CalendarWidget) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	r := &calendarRenderer{calendar: c, content: container.NewVBox()}
+	r.rebuild()
+	return r
+}
+
+// SetMonth moves the widget to the month containing t, clearing selection.
+func (c *CalendarWidget) SetMonth(t time.Time) {
+	c.currentTime = t
+	c.Model = NewSelectableMonth(t)
+	c.lastClickedDay = 0
+	c.Refresh()
+}
+
+func (c *CalendarWidget) PreviousMonth() {
+	c.currentTime = c.currentTime.AddDate(0, -1, 0)
+	c.Model.Previous()
+	c.lastClickedDay = 0
+	c.Refresh()
+}
+
+func (c *CalendarWidget) NextMonth() {
+	c.currentTime = c.currentTime.AddDate(0, 1, 0)
+	c.Model.Next()
+	c.lastClickedDay = 0
+	c.Refresh()
+}
+
+// AddEvent appends e to Events and re-renders so the day it falls on is
+// decorated.
+func (c *CalendarWidget) AddEvent(e CalendarEvent) {
+	c.Events = append(c.Events, e)
+	c.Refresh()
+}
+
+// RemoveEvent removes the first event matching e and re-renders.
+func (c *CalendarWidget) RemoveEvent(e CalendarEvent) {
+	for i, existing := range c.Events {
+		if existing == e {
+			c.Events = append(c.Events[:i], c.Events[i+1:]...)
+			c.Refresh()
+			return
+		}
+	}
+}
+
+// LoadICS parses an iCalendar document from r and merges its VEVENT entries
+// into Events.
+func (c *CalendarWidget) LoadICS(r io.Reader) error {
+	events, err := ParseICS(r)
+	if err != nil {
+		return err
+	}
+
+	c.Events = append(c.Events, events...)
+	c.Refresh()
+	return nil
+}
+
+// handleDayTapped toggles day's selection, or, on a shift-click, selects
+// every day between the last clicked day and day.
+func (c *CalendarWidget) handleDayTapped(day int, shift bool) {
+	if shift && c.lastClickedDay != 0 {
+		lo, hi := c.lastClickedDay, day
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		for d := lo; d <= hi; d++ {
+			c.Model.S[d] = true
+		}
+	} else {
+		c.Model.S[day] = !c.Model.S[day]
+	}
+	c.lastClickedDay = day
+
+	if c.onDateSelected != nil {
+		c.onDateSelected(time.Date(c.Model.Year, c.Model.Month, day, 0, 0, 0, 0, time.Local))
+	}
+	c.notifySelectionChanged()
+	c.Refresh()
+}
+
+// handleWeekdayTapped toggles every occurrence of d in the visible month.
+func (c *CalendarWidget) handleWeekdayTapped(d time.Weekday) {
+	if c.Model.Ws[d] {
+		c.Model.DeselectWeekday(d)
+	} else {
+		c.Model.SelectWeekday(d)
+	}
+	c.notifySelectionChanged()
+	c.Refresh()
+}
+
+func (c *CalendarWidget) notifySelectionChanged() {
+	if c.OnSelectionChanged != nil {
+		c.OnSelectionChanged(c.Model.SelectedDates())
+	}
+}
+
+type calendarRenderer struct {
+	calendar *CalendarWidget
+	content  *fyne.Container
+}
+
+func (r *calendarRenderer) Layout(size fyne.Size) {
+	r.content.Resize(size)
+}
+
+func (r *calendarRenderer) MinSize() fyne.Size {
+	return r.content.MinSize()
+}
+
+func (r *calendarRenderer) Refresh() {
+	r.rebuild()
+	r.content.Refresh()
+}
+
+func (r *calendarRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.content}
+}
+
+func (r *calendarRenderer) Destroy() {}
+
+// rebuild regenerates the weekday header and day grid from the widget's
+// Model, since selection state can change the Importance of any cell.
+func (r *calendarRenderer) rebuild() {
+	cal := r.calendar
+	model := cal.Model
+
+	header := container.New(NewAdaptiveCalendarLayout(fyne.NewSize(40, 28), 7, theme.Padding()))
+	for i, wd := range weekdays {
+		wd := wd
+		btn := widget.NewButton(weekdayNames[i], func() {
+			cal.handleWeekdayTapped(wd)
+		})
+		if model.Ws[wd] {
+			btn.Importance = widget.HighImportance
+		}
+		header.Add(btn)
+	}
+
+	grid := container.New(NewAdaptiveCalendarLayout(fyne.NewSize(40, 36), 7, theme.Padding()))
+	for i := 0; i < model.Pad; i++ {
+		grid.Add(widget.NewLabel(""))
+	}
+	for day := 1; day <= model.DaysIn; day++ {
+		day := day
+		dayTime := time.Date(model.Year, model.Month, day, 0, 0, 0, 0, time.Local)
+		cell := newDayCell(fmt.Sprintf("%d", day), func(shift bool) {
+			cal.handleDayTapped(day, shift)
+		})
+		if model.S[day] || len(eventsOnDay(cal.Events, dayTime)) > 0 {
+			cell.setSelected(true)
+		}
+		grid.Add(cell)
+	}
+
+	r.content.Objects = []fyne.CanvasObject{header, grid}
+}
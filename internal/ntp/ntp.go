@@ -0,0 +1,86 @@
+// Package ntp implements a minimal SNTP (RFC 4330) client for reading the
+// time offset from an NTP server, without touching the local clock.
+package ntp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+)
+
+// ntpEpochOffset is the number of seconds between the NTP epoch (1900-01-01)
+// and the Unix epoch (1970-01-01).
+const ntpEpochOffset = 2208988800
+
+// DefaultTimeout bounds how long Query waits for a reply.
+const DefaultTimeout = 5 * time.Second
+
+// Response is the result of an SNTP query.
+type Response struct {
+	// Time is the server's transmit timestamp.
+	Time time.Time
+	// Offset is how far ahead of the local clock the server is; add it to
+	// time.Now() to get the corrected time.
+	Offset time.Duration
+	// RTT is the round-trip delay to the server.
+	RTT time.Duration
+	// Stratum is the server's NTP stratum (1 = reference clock).
+	Stratum byte
+}
+
+// Query sends a single SNTP request to server (host, or host:port) and
+// returns its offset, round-trip delay and stratum.
+func Query(server string) (Response, error) {
+	return QueryWithTimeout(server, DefaultTimeout)
+}
+
+// QueryWithTimeout is Query with an explicit network timeout.
+func QueryWithTimeout(server string, timeout time.Duration) (Response, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+
+	conn, err := net.DialTimeout("udp", server, timeout)
+	if err != nil {
+		return Response{}, fmt.Errorf("ntp: dial %s: %w", server, err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+
+	var pkt [48]byte
+	pkt[0] = 0x1B // LI = 0, VN = 3, Mode = 3 (client)
+
+	t1 := time.Now()
+	if _, err := conn.Write(pkt[:]); err != nil {
+		return Response{}, fmt.Errorf("ntp: write: %w", err)
+	}
+	if _, err := conn.Read(pkt[:]); err != nil {
+		return Response{}, fmt.Errorf("ntp: read: %w", err)
+	}
+	t4 := time.Now()
+
+	stratum := pkt[1]
+	t2 := ntpToTime(binary.BigEndian.Uint32(pkt[32:36]), binary.BigEndian.Uint32(pkt[36:40]))
+	t3 := ntpToTime(binary.BigEndian.Uint32(pkt[40:44]), binary.BigEndian.Uint32(pkt[44:48]))
+
+	return computeResponse(t1, t2, t3, t4, stratum), nil
+}
+
+// computeResponse applies the standard SNTP offset/round-trip-delay formulas
+// to the four exchange timestamps, split out from QueryWithTimeout so the
+// arithmetic is testable without a network round trip.
+func computeResponse(t1, t2, t3, t4 time.Time, stratum byte) Response {
+	return Response{
+		Time:    t3,
+		Offset:  (t2.Sub(t1) + t3.Sub(t4)) / 2,
+		RTT:     t4.Sub(t1) - t3.Sub(t2),
+		Stratum: stratum,
+	}
+}
+
+func ntpToTime(seconds, fraction uint32) time.Time {
+	sec := int64(seconds) - ntpEpochOffset
+	nsec := int64(float64(fraction) / (1 << 32) * 1e9)
+	return time.Unix(sec, nsec).UTC()
+}
@@ -0,0 +1,62 @@
+package ntp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNtpToTime(t *testing.T) {
+	cases := []struct {
+		name     string
+		seconds  uint32
+		fraction uint32
+		want     time.Time
+	}{
+		{
+			name: "ntp epoch",
+			want: time.Unix(-ntpEpochOffset, 0).UTC(),
+		},
+		{
+			name:    "unix epoch",
+			seconds: ntpEpochOffset,
+			want:    time.Unix(0, 0).UTC(),
+		},
+		{
+			name:     "half second fraction",
+			seconds:  ntpEpochOffset + 100,
+			fraction: 1 << 31,
+			want:     time.Unix(100, 500000000).UTC(),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := ntpToTime(c.seconds, c.fraction)
+			if !got.Equal(c.want) {
+				t.Errorf("ntpToTime(%d, %d) = %v, want %v", c.seconds, c.fraction, got, c.want)
+			}
+		})
+	}
+}
+
+func TestComputeResponse(t *testing.T) {
+	t1 := time.Unix(1000, 0)
+	t2 := time.Unix(1005, 0)
+	t3 := time.Unix(1005, 100_000_000)
+	t4 := time.Unix(1000, 200_000_000)
+
+	resp := computeResponse(t1, t2, t3, t4, 2)
+
+	if !resp.Time.Equal(t3) {
+		t.Errorf("Time = %v, want %v", resp.Time, t3)
+	}
+	if want := 4950 * time.Millisecond; resp.Offset != want {
+		t.Errorf("Offset = %v, want %v", resp.Offset, want)
+	}
+	if want := 100 * time.Millisecond; resp.RTT != want {
+		t.Errorf("RTT = %v, want %v", resp.RTT, want)
+	}
+	if resp.Stratum != 2 {
+		t.Errorf("Stratum = %d, want 2", resp.Stratum)
+	}
+}
@@ -0,0 +1,26 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+package sysclock
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// runElevated runs name with args under pkexec, falling back to sudo, so
+// that SetSystemTime works even when the app itself isn't running as root.
+// sudo is run with -n, so it fails rather than prompting when a password is
+// required.
+func runElevated(name string, args ...string) error {
+	full := append([]string{name}, args...)
+
+	if path, err := exec.LookPath("pkexec"); err == nil {
+		return exec.Command(path, full...).Run()
+	}
+
+	if path, err := exec.LookPath("sudo"); err == nil {
+		return exec.Command(path, append([]string{"-n"}, full...)...).Run()
+	}
+
+	return fmt.Errorf("sysclock: need root to set the clock, and neither pkexec nor sudo is available")
+}
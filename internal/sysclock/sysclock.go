@@ -0,0 +1,17 @@
+// Package sysclock sets the operating system's wall clock to an arbitrary
+// time, dispatching to a platform-specific backend and requesting elevated
+// privileges when the current process doesn't already have them.
+package sysclock
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUnsupported is returned by SetSystemTime on platforms without a backend.
+var ErrUnsupported = errors.New("sysclock: setting the system clock is not supported on this platform")
+
+// SetSystemTime sets the operating system clock to t.
+func SetSystemTime(t time.Time) error {
+	return setSystemTime(t)
+}
@@ -0,0 +1,11 @@
+//go:build freebsd || netbsd || openbsd
+
+package sysclock
+
+import "time"
+
+// setSystemTime shells out to an elevated `date`, the common way to set the
+// clock across the BSDs without cgo or per-OS syscall bindings.
+func setSystemTime(t time.Time) error {
+	return runElevated("date", t.Format("200601021504.05"))
+}
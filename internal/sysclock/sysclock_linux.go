@@ -0,0 +1,20 @@
+//go:build linux
+
+package sysclock
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// setSystemTime sets CLOCK_REALTIME directly when the caller already has
+// CAP_SYS_TIME, and falls back to an elevated `date -s` otherwise.
+func setSystemTime(t time.Time) error {
+	tv := unix.NsecToTimeval(t.UnixNano())
+	if err := unix.Settimeofday(&tv); err == nil {
+		return nil
+	}
+
+	return runElevated("date", "-s", t.Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !darwin && !windows && !freebsd && !netbsd && !openbsd
+
+package sysclock
+
+import "time"
+
+func setSystemTime(time.Time) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,81 @@
+//go:build windows
+
+package sysclock
+
+import (
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modkernel32       = syscall.NewLazyDLL("kernel32.dll")
+	procSetSystemTime = modkernel32.NewProc("SetSystemTime")
+)
+
+// systemTime mirrors the Win32 SYSTEMTIME struct expected by SetSystemTime.
+type systemTime struct {
+	Year         uint16
+	Month        uint16
+	DayOfWeek    uint16
+	Day          uint16
+	Hour         uint16
+	Minute       uint16
+	Second       uint16
+	Milliseconds uint16
+}
+
+// setSystemTime enables SeSystemtimePrivilege on the current process token
+// and calls the Win32 SetSystemTime API, which golang.org/x/sys/windows
+// doesn't wrap, so it's invoked directly through kernel32.dll. No UAC
+// elevation is requested: when the process token lacks the privilege, the
+// SetSystemTime call fails and its error is returned.
+func setSystemTime(t time.Time) error {
+	if err := enablePrivilege("SeSystemtimePrivilege"); err != nil {
+		return err
+	}
+
+	u := t.UTC()
+	st := systemTime{
+		Year:   uint16(u.Year()),
+		Month:  uint16(u.Month()),
+		Day:    uint16(u.Day()),
+		Hour:   uint16(u.Hour()),
+		Minute: uint16(u.Minute()),
+		Second: uint16(u.Second()),
+	}
+
+	if ret, _, err := procSetSystemTime.Call(uintptr(unsafe.Pointer(&st))); ret == 0 {
+		return err
+	}
+	return nil
+}
+
+// enablePrivilege turns on a named privilege in the current process token,
+// e.g. "SeSystemtimePrivilege", which is disabled by default even for
+// administrators.
+func enablePrivilege(name string) error {
+	var token windows.Token
+	proc := windows.CurrentProcess()
+	if err := windows.OpenProcessToken(proc, windows.TOKEN_ADJUST_PRIVILEGES|windows.TOKEN_QUERY, &token); err != nil {
+		return err
+	}
+	defer token.Close()
+
+	var luid windows.LUID
+	if err := windows.LookupPrivilegeValue(nil, windows.StringToUTF16Ptr(name), &luid); err != nil {
+		return err
+	}
+
+	priv := windows.Tokenprivileges{
+		PrivilegeCount: 1,
+		Privileges: [1]windows.LUIDAndAttributes{{
+			Luid:       luid,
+			Attributes: windows.SE_PRIVILEGE_ENABLED,
+		}},
+	}
+
+	return windows.AdjustTokenPrivileges(token, false, &priv, 0, nil, nil)
+}
@@ -2,78 +2,171 @@ package main
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
 	"fyne.io/fyne/v2/widget"
-)
 
-// CalendarWidget represents a custom calendar widget
-type CalendarWidget struct {
-	widget.BaseWidget
-	currentTime    time.Time
-	selectedTime   time.Time
-	onDateSelected func(time.Time)
-}
+	"github.com/tiennm99/time-changer/calendar"
+	"github.com/tiennm99/time-changer/internal/ntp"
+	"github.com/tiennm99/time-changer/internal/sysclock"
+)
 
-func NewCalendarWidget(initialTime time.Time, onDateSelected func(time.Time)) *CalendarWidget {
-	c := &CalendarWidget{
-		currentTime:    initialTime,
-		selectedTime:   initialTime,
-		onDateSelected: onDateSelected,
-	}
-	c.ExtendBaseWidget(c)
-	return c
-}
+// maxRecentServers bounds how many NTP servers the sync tab remembers.
+const maxRecentServers = 5
+
+// createMultiSelectView builds the "Multi-select" tab, exercising
+// CalendarWidget's SelectableMonth-backed shift-click range selection and
+// weekday-column toggling.
+func createMultiSelectView(initialTime time.Time) fyne.CanvasObject {
+	selectionLabel := widget.NewLabel("Selected: (none)")
+	selectionLabel.Wrapping = fyne.TextWrapWord
+
+	calWidget := calendar.NewCalendarWidget(initialTime, nil)
+	calWidget.OnSelectionChanged = func(dates []time.Time) {
+		if len(dates) == 0 {
+			selectionLabel.SetText("Selected: (none)")
+			return
+		}
 
-func (c *CalendarWidget) CreateRenderer() fyne.WidgetRenderer {
-	c.ExtendBaseWidget(c)
-	return &calendarRenderer{
-		calendar: c,
-		objects:  []fyne.CanvasObject{},
+		formatted := make([]string, len(dates))
+		for i, d := range dates {
+			formatted[i] = d.Format("2006-01-02")
+		}
+		selectionLabel.SetText("Selected: " + strings.Join(formatted, ", "))
 	}
-}
 
-func (c *CalendarWidget) SetMonth(t time.Time) {
-	c.currentTime = t
-	c.Refresh()
-}
-
-func (c *CalendarWidget) PreviousMonth() {
-	c.currentTime = c.currentTime.AddDate(0, -1, 0)
-	c.Refresh()
-}
+	prevBtn := widget.NewButton("<", calWidget.PreviousMonth)
+	nextBtn := widget.NewButton(">", calWidget.NextMonth)
 
-func (c *CalendarWidget) NextMonth() {
-	c.currentTime = c.currentTime.AddDate(0, 1, 0)
-	c.Refresh()
+	return container.NewVBox(
+		widget.NewLabel("Shift-click a day to select a range. Click a weekday header to select every occurrence of that weekday."),
+		container.NewBorder(nil, nil, prevBtn, nextBtn, nil),
+		calWidget,
+		widget.NewSeparator(),
+		selectionLabel,
+	)
 }
 
-type calendarRenderer struct {
-	calendar *CalendarWidget
-	objects  []fyne.CanvasObject
-}
+// applyTime confirms with the user, then pushes t to the OS clock.
+func applyTime(w fyne.Window, t time.Time, label string) {
+	dialog.ShowConfirm(
+		"Set system time",
+		fmt.Sprintf("Set the system clock to %s?", label),
+		func(confirmed bool) {
+			if !confirmed {
+				return
+			}
 
-func (r *calendarRenderer) Layout(size fyne.Size) {
-	// Layout is handled by the container
+			// SetSystemTime can block for seconds on a pkexec
+			// authentication prompt, so run it off the UI goroutine.
+			go func() {
+				err := sysclock.SetSystemTime(t)
+				fyne.Do(func() {
+					if err != nil {
+						dialog.ShowError(err, w)
+					}
+				})
+			}()
+		},
+		w,
+	)
 }
 
-func (r *calendarRenderer) MinSize() fyne.Size {
-	return fyne.NewSize(350, 300)
-}
+// createNTPSyncView builds the "Sync from NTP" tab: query a configurable
+// server, show its offset/RTT/stratum, and offer to apply the corrected
+// time via the same system-clock backend as the manual Apply button.
+func createNTPSyncView(w fyne.Window) fyne.CanvasObject {
+	const defaultServer = "pool.ntp.org"
+	recentServers := []string{defaultServer}
+
+	serverEntry := widget.NewSelectEntry(recentServers)
+	serverEntry.SetText(defaultServer)
+
+	offsetLabel := widget.NewLabel("Offset: -")
+	rttLabel := widget.NewLabel("Round-trip delay: -")
+	stratumLabel := widget.NewLabel("Stratum: -")
+
+	var lastOffset time.Duration
+	haveResult := false
+
+	applyButton := widget.NewButton("Apply corrected time", nil)
+	applyButton.Disable()
+
+	var syncButton *widget.Button
+	syncButton = widget.NewButton("Sync", func() {
+		server := serverEntry.Text
+
+		syncButton.Disable()
+		offsetLabel.SetText("Offset: syncing...")
+		rttLabel.SetText("Round-trip delay: syncing...")
+		stratumLabel.SetText("Stratum: syncing...")
+
+		go func() {
+			resp, err := ntp.Query(server)
+
+			fyne.Do(func() {
+				syncButton.Enable()
+
+				if err != nil {
+					offsetLabel.SetText("Offset: -")
+					rttLabel.SetText("Round-trip delay: -")
+					stratumLabel.SetText("Stratum: -")
+					dialog.ShowError(err, w)
+					return
+				}
+
+				lastOffset = resp.Offset
+				haveResult = true
+				offsetLabel.SetText(fmt.Sprintf("Offset: %s", resp.Offset))
+				rttLabel.SetText(fmt.Sprintf("Round-trip delay: %s", resp.RTT))
+				stratumLabel.SetText(fmt.Sprintf("Stratum: %d", resp.Stratum))
+				applyButton.Enable()
+
+				remembered := false
+				for _, s := range recentServers {
+					if s == server {
+						remembered = true
+						break
+					}
+				}
+				if !remembered {
+					recentServers = append([]string{server}, recentServers...)
+					if len(recentServers) > maxRecentServers {
+						recentServers = recentServers[:maxRecentServers]
+					}
+					serverEntry.SetOptions(recentServers)
+				}
+			})
+		}()
+	})
 
-func (r *calendarRenderer) Refresh() {
-	r.calendar.BaseWidget.Refresh()
-}
+	applyButton.OnTapped = func() {
+		if !haveResult {
+			return
+		}
+		corrected := time.Now().Add(lastOffset)
+		applyTime(w, corrected, corrected.Format("2006-01-02 15:04:05"))
+	}
 
-func (r *calendarRenderer) Objects() []fyne.CanvasObject {
-	return r.objects
+	return container.NewVBox(
+		widget.NewLabel("NTP server:"),
+		serverEntry,
+		syncButton,
+		widget.NewSeparator(),
+		offsetLabel,
+		rttLabel,
+		stratumLabel,
+		widget.NewSeparator(),
+		applyButton,
+	)
 }
 
-func (r *calendarRenderer) Destroy() {}
-
 // CreateTimePicker creates a shadcn-style time picker
 func CreateTimePicker(currentTime time.Time, onTimeSelected func(time.Time)) fyne.CanvasObject {
 	// Use pointers to allow modification in closures
@@ -159,99 +252,6 @@ func generateRange(min, max int) []string {
 	return result
 }
 
-// CreateCalendarView creates a calendar view widget
-func CreateCalendarView(initialTime time.Time, onDateSelected func(time.Time)) fyne.CanvasObject {
-	currentMonth := initialTime
-
-	// Month/Year label
-	monthYearLabel := widget.NewLabel("")
-	monthYearLabel.Alignment = fyne.TextAlignCenter
-	monthYearLabel.TextStyle = fyne.TextStyle{Bold: true}
-
-	// Navigation buttons
-	prevBtn := widget.NewButton("<", nil)
-	nextBtn := widget.NewButton(">", nil)
-
-	// Calendar grid
-	calendarGrid := container.NewGridWithColumns(7)
-
-	updateCalendar := func() {
-		// Update month/year label
-		monthYearLabel.SetText(currentMonth.Format("January 2006"))
-
-		// Clear grid
-		calendarGrid.Objects = nil
-
-		// Add day headers
-		days := []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
-		for _, day := range days {
-			dayLabel := widget.NewLabel(day)
-			dayLabel.Alignment = fyne.TextAlignCenter
-			calendarGrid.Add(dayLabel)
-		}
-
-		// Get first day of month and number of days
-		firstOfMonth := time.Date(currentMonth.Year(), currentMonth.Month(), 1, 0, 0, 0, 0, time.Local)
-		lastOfMonth := firstOfMonth.AddDate(0, 1, -1)
-		firstDayWeekday := int(firstOfMonth.Weekday())
-		daysInMonth := lastOfMonth.Day()
-
-		// Add empty cells for days before the first of the month
-		for i := 0; i < firstDayWeekday; i++ {
-			calendarGrid.Add(widget.NewLabel(""))
-		}
-
-		// Add day buttons
-		today := time.Now()
-		for day := 1; day <= daysInMonth; day++ {
-			dayTime := time.Date(currentMonth.Year(), currentMonth.Month(), day, 0, 0, 0, 0, time.Local)
-			isToday := dayTime.Year() == today.Year() &&
-				dayTime.Month() == today.Month() &&
-				dayTime.Day() == today.Day()
-
-			dayBtn := widget.NewButton(fmt.Sprintf("%d", day), nil)
-
-			if isToday {
-				dayBtn.Importance = widget.HighImportance
-			}
-
-			dayBtn.OnTapped = func() {
-				onDateSelected(dayTime)
-			}
-
-			calendarGrid.Add(dayBtn)
-		}
-
-		calendarGrid.Refresh()
-	}
-
-	// Setup navigation
-	prevBtn.OnTapped = func() {
-		currentMonth = currentMonth.AddDate(0, -1, 0)
-		updateCalendar()
-	}
-
-	nextBtn.OnTapped = func() {
-		currentMonth = currentMonth.AddDate(0, 1, 0)
-		updateCalendar()
-	}
-
-	// Initial calendar update
-	updateCalendar()
-
-	// Navigation header
-	navHeader := container.NewBorder(nil, nil, prevBtn, nextBtn, monthYearLabel)
-
-	// Combine everything
-	calendarContainer := container.NewVBox(
-		navHeader,
-		widget.NewSeparator(),
-		calendarGrid,
-	)
-
-	return calendarContainer
-}
-
 func main() {
 	a := app.New()
 	w := a.NewWindow("Time Changer")
@@ -259,6 +259,7 @@ func main() {
 
 	// Get current time
 	currentTime := time.Now()
+	var events []calendar.CalendarEvent
 
 	// Selected date label
 	selectedDateLabel := widget.NewLabel("Selected Date:")
@@ -284,41 +285,149 @@ func main() {
 		))
 	}
 
-	// Calendar with date selection callback
-	calendar := CreateCalendarView(currentTime, func(selectedDate time.Time) {
+	// Date entry with keyboard input and a popup calendar
+	dateEntry := calendar.NewDateEntry(currentTime, "2006-01-02")
+
+	// Time picker, rebuilt whenever an imported event jumps the selected time
+	timePickerSlot := container.NewStack()
+	rebuildTimePicker := func() {
+		timePickerSlot.Objects = []fyne.CanvasObject{CreateTimePicker(currentTime, func(selectedTime time.Time) {
+			currentTime = selectedTime
+			updatePreview()
+		})}
+		timePickerSlot.Refresh()
+	}
+
+	onDateSelected := func(selectedDate time.Time) {
 		selectedDateValue.SetText(selectedDate.Format("2006-01-02"))
+		// SetDate fires OnDateChanged, which is this function; skip it when
+		// the entry already holds the date so the two don't recurse.
+		if !dateEntry.Date().Equal(selectedDate) {
+			dateEntry.SetDate(selectedDate)
+		}
 		currentTime = selectedDate
 		updatePreview()
-	})
+	}
 
-	// Time picker with callback
-	timePicker := CreateTimePicker(currentTime, func(selectedTime time.Time) {
-		currentTime = selectedTime
+	onEventSelected := func(eventStart time.Time) {
+		currentTime = eventStart
+		selectedDateValue.SetText(eventStart.Format("2006-01-02"))
+		dateEntry.SetDate(eventStart)
+		rebuildTimePicker()
 		updatePreview()
-	})
+	}
+
+	// Calendar grid, rebuilt whenever events are imported so new days get decorated
+	calendarSlot := container.NewStack()
+	rebuildCalendar := func() {
+		calendarSlot.Objects = []fyne.CanvasObject{calendar.CreateCalendarView(currentTime, events, onDateSelected, onEventSelected)}
+		calendarSlot.Refresh()
+	}
+
+	dateEntry.OnDateChanged = onDateSelected
+
+	rebuildTimePicker()
+	rebuildCalendar()
 
 	// Set current time button
 	setCurrentButton := widget.NewButton("Set to Current Time", func() {
 		now := time.Now()
 		currentTime = now
 		selectedDateValue.SetText(now.Format("2006-01-02"))
+		dateEntry.SetDate(now)
 		updatePreview()
 	})
 
+	// Apply button pushes the selected date/time to the OS clock
+	applyButton := widget.NewButton("Apply", func() {
+		date, _ := time.Parse("2006-01-02", selectedDateValue.Text)
+		t := time.Date(date.Year(), date.Month(), date.Day(),
+			currentTime.Hour(), currentTime.Minute(), currentTime.Second(), 0, time.Local)
+		applyTime(w, t, selectedDateTimeLabel.Text)
+	})
+	applyButton.Importance = widget.HighImportance
+
 	// Layout
 	content := container.NewVBox(
 		selectedDateLabel,
-		calendar,
+		dateEntry,
+		calendarSlot,
 		selectedDateValue,
 		widget.NewSeparator(),
-		timePicker,
+		timePickerSlot,
 		widget.NewSeparator(),
 		setCurrentButton,
 		widget.NewSeparator(),
 		widget.NewLabel("Preview:"),
-		selectedDateTimeLabel,
+		container.NewBorder(nil, nil, nil, applyButton, selectedDateTimeLabel),
 	)
 
-	w.SetContent(content)
+	// "Import .ics" menu actions load VEVENT entries from a local file or a
+	// URL and decorate the days they fall on
+	importFileItem := fyne.NewMenuItem("Import .ics...", func() {
+		dialog.ShowFileOpen(func(reader fyne.URIReadCloser, err error) {
+			if err != nil {
+				dialog.ShowError(err, w)
+				return
+			}
+			if reader == nil {
+				return
+			}
+			defer reader.Close()
+
+			imported, err := calendar.ParseICS(reader)
+			if err != nil {
+				dialog.ShowError(err, w)
+				return
+			}
+
+			events = append(events, imported...)
+			rebuildCalendar()
+		}, w)
+	})
+
+	icsHTTPClient := &http.Client{Timeout: 15 * time.Second}
+
+	importURLItem := fyne.NewMenuItem("Import .ics from URL...", func() {
+		dialog.NewEntryDialog("Import .ics from URL", "URL", func(rawURL string) {
+			if rawURL == "" {
+				return
+			}
+
+			go func() {
+				resp, err := icsHTTPClient.Get(rawURL)
+				if err != nil {
+					fyne.Do(func() { dialog.ShowError(err, w) })
+					return
+				}
+				defer resp.Body.Close()
+
+				if resp.StatusCode != http.StatusOK {
+					err := fmt.Errorf("import ics: unexpected status %s", resp.Status)
+					fyne.Do(func() { dialog.ShowError(err, w) })
+					return
+				}
+
+				imported, err := calendar.ParseICS(resp.Body)
+				if err != nil {
+					fyne.Do(func() { dialog.ShowError(err, w) })
+					return
+				}
+
+				fyne.Do(func() {
+					events = append(events, imported...)
+					rebuildCalendar()
+				})
+			}()
+		}, w).Show()
+	})
+
+	w.SetMainMenu(fyne.NewMainMenu(fyne.NewMenu("File", importFileItem, importURLItem)))
+
+	w.SetContent(container.NewAppTabs(
+		container.NewTabItem("Manual", content),
+		container.NewTabItem("Multi-select", createMultiSelectView(currentTime)),
+		container.NewTabItem("Sync from NTP", createNTPSyncView(w)),
+	))
 	w.ShowAndRun()
 }